@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package testing holds fixture ABIs shared by the precompile package's tests.
+package testing
+
+// MockMethodsABI is a small fixture ABI exercising method_validation's matching rules: a
+// plain method (exampleFunc), a method with no outputs (zeroReturn), and an overloaded
+// Solidity function (transfer) to exercise findMatchingABIMethod's overload resolution.
+const MockMethodsABI = `[
+	{
+		"type": "function",
+		"name": "exampleFunc",
+		"inputs": [
+			{ "name": "amount", "type": "uint256" },
+			{ "name": "addr", "type": "address" },
+			{
+				"name": "items",
+				"type": "tuple[]",
+				"components": [
+					{ "name": "value", "type": "uint256" }
+				]
+			}
+		],
+		"outputs": [
+			{ "name": "", "type": "bool" }
+		]
+	},
+	{
+		"type": "function",
+		"name": "zeroReturn",
+		"inputs": [],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "transfer",
+		"inputs": [
+			{ "name": "to", "type": "address" },
+			{ "name": "amount", "type": "uint256" }
+		],
+		"outputs": [
+			{ "name": "", "type": "bool" }
+		]
+	},
+	{
+		"type": "function",
+		"name": "transfer",
+		"inputs": [
+			{ "name": "to", "type": "address" },
+			{ "name": "amount", "type": "uint256" },
+			{ "name": "data", "type": "bytes" }
+		],
+		"outputs": [
+			{ "name": "", "type": "bool" }
+		]
+	},
+	{
+		"type": "function",
+		"name": "foo",
+		"inputs": [
+			{ "name": "amount", "type": "uint256" },
+			{ "name": "addr", "type": "address" }
+		],
+		"outputs": [
+			{ "name": "", "type": "bool" }
+		]
+	},
+	{
+		"type": "function",
+		"name": "foobar",
+		"inputs": [
+			{ "name": "amount", "type": "uint256" },
+			{ "name": "addr", "type": "address" }
+		],
+		"outputs": [
+			{ "name": "", "type": "bool" }
+		]
+	},
+	{
+		"type": "function",
+		"name": "sendCoin",
+		"inputs": [
+			{
+				"name": "coin",
+				"type": "tuple",
+				"components": [
+					{ "name": "denom", "type": "string" },
+					{ "name": "amount", "type": "uint256" }
+				]
+			}
+		],
+		"outputs": [
+			{ "name": "", "type": "bool" }
+		]
+	},
+	{
+		"type": "function",
+		"name": "multiOutput",
+		"inputs": [],
+		"outputs": [
+			{ "name": "", "type": "bool" },
+			{ "name": "", "type": "uint256" }
+		]
+	},
+	{
+		"type": "function",
+		"name": "create",
+		"inputs": [
+			{
+				"name": "handled",
+				"type": "tuple",
+				"components": [
+					{ "name": "value", "type": "string" }
+				]
+			}
+		],
+		"outputs": [
+			{ "name": "", "type": "address" }
+		]
+	}
+]`
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package precompile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+)
+
+// Container binds a precompile impl to its ABI and dispatches calls to it at runtime via
+// reflection, using findMatchingABIMethod to build the selector-to-method table once up
+// front. It is the reflection-based counterpart to a generated dispatcher (see the generator
+// package): prefer a generated dispatcher on hot call paths, and use a Container for impls
+// that haven't generated one yet.
+type Container struct {
+	impl     reflect.Value
+	implType reflect.Type
+	adapters *AdapterRegistry
+	dispatch map[[4]byte]boundMethod
+}
+
+// boundMethod pairs an impl method with the ABI method findMatchingABIMethod matched it to.
+type boundMethod struct {
+	implMethod reflect.Method
+	abiMethod  abi.Method
+}
+
+// ContainerOption configures a Container at construction time, before NewContainer validates
+// impl's methods against abiMethods.
+type ContainerOption func(*Container)
+
+// WithTypeAdapter registers adapter for goType on the Container being built, before its methods
+// are validated against abiMethods. Unlike Container.RegisterTypeAdapter, an adapter registered this way
+// does participate in validation (see validateArg), so it can rescue a method NewContainer would
+// otherwise reject for a Go/ABI type mismatch.
+func WithTypeAdapter(goType reflect.Type, adapter TypeAdapter) ContainerOption {
+	return func(c *Container) {
+		c.adapters.Register(goType, adapter)
+	}
+}
+
+// NewContainer builds a Container for impl against abiMethods, matching each of impl's
+// exported methods to an ABI method via findMatchingABIMethod. It returns an error if any
+// matched method's signature doesn't validate against its ABI method. opts are applied before
+// validation, so a WithTypeAdapter option can make a method validate that otherwise wouldn't.
+func NewContainer(
+	impl interface{}, abiMethods map[string]abi.Method, opts ...ContainerOption,
+) (*Container, error) {
+	implVal := reflect.ValueOf(impl)
+	implType := implVal.Type()
+
+	c := &Container{
+		impl:     implVal,
+		implType: implType,
+		adapters: NewAdapterRegistry(),
+		dispatch: make(map[[4]byte]boundMethod),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	for i := 0; i < implType.NumMethod(); i++ {
+		implMethod := implType.Method(i)
+
+		abiName, err := findMatchingABIMethod(implMethod, abiMethods, c.adapters)
+		if err != nil {
+			return nil, fmt.Errorf("container: %s: %w", implMethod.Name, err)
+		}
+		if abiName == "" {
+			continue
+		}
+
+		abiMethod := abiMethods[abiName]
+		c.dispatch[selectorOf(abiMethod.ID)] = boundMethod{
+			implMethod: implMethod,
+			abiMethod:  abiMethod,
+		}
+	}
+
+	return c, nil
+}
+
+// RegisterTypeAdapter registers adapter for goType on this Container only, for use by Call. A
+// Container has already been validated against abiMethods by the time a caller can reach a
+// *Container to call this, so an adapter registered here cannot rescue a method NewContainer
+// rejected during construction - it can only change how Call converts arguments and return
+// values for methods that already validated. Use WithTypeAdapter instead for an adapter that a
+// method's validation should be able to rely on.
+func (c *Container) RegisterTypeAdapter(goType reflect.Type, adapter TypeAdapter) {
+	c.adapters.Register(goType, adapter)
+}
+
+// Call dispatches a single precompile call, identified by its 4-byte selector, to the impl
+// method it was bound to in NewContainer. Arguments and return values whose Go type has a
+// registered TypeAdapter (container-local, falling back to the package-wide default registry)
+// are converted through that adapter instead of being passed through directly.
+func (c *Container) Call(ctx context.Context, selector [4]byte, input []byte) ([]byte, error) {
+	bound, ok := c.dispatch[selector]
+	if !ok {
+		return nil, fmt.Errorf("container: no method registered for selector %x", selector)
+	}
+
+	unpacked, err := bound.abiMethod.Inputs.Unpack(input)
+	if err != nil {
+		return nil, fmt.Errorf("container: unpacking %s: %w", bound.abiMethod.Name, err)
+	}
+
+	implFuncType := bound.implMethod.Func.Type()
+
+	callArgs := make([]reflect.Value, 0, len(unpacked)+2)
+	callArgs = append(callArgs, c.impl, reflect.ValueOf(ctx))
+
+	for i, v := range unpacked {
+		paramType := implFuncType.In(i + 2)
+
+		if adapter, found := c.lookupAdapter(paramType); found {
+			argVal, adaptErr := adapter.FromEVM(bound.abiMethod.Inputs[i].Type, v)
+			if adaptErr != nil {
+				return nil, fmt.Errorf(
+					"container: %s: arg %d: %w", bound.abiMethod.Name, i, adaptErr,
+				)
+			}
+
+			callArgs = append(callArgs, argVal)
+
+			continue
+		}
+
+		// abi.Arguments.Unpack hands a tuple back as its own anonymous struct type; convert it to
+		// the impl's struct type, whose fields validateArg has already checked one by one.
+		argVal := reflect.ValueOf(v)
+		if argVal.Type() != paramType && argVal.Type().ConvertibleTo(paramType) {
+			argVal = argVal.Convert(paramType)
+		}
+
+		callArgs = append(callArgs, argVal)
+	}
+
+	rets := bound.implMethod.Func.Call(callArgs)
+
+	if errVal := rets[len(rets)-1]; !errVal.IsNil() {
+		//nolint:forcetypeassert // validateOutputs guarantees the last return is an error.
+		return nil, errVal.Interface().(error)
+	}
+
+	packed := make([]interface{}, 0, len(rets)-1)
+	for i := 0; i < len(rets)-1; i++ {
+		retVal := rets[i]
+
+		if adapter, found := c.lookupAdapter(retVal.Type()); found {
+			evmVal, adaptErr := adapter.ToEVM(retVal)
+			if adaptErr != nil {
+				return nil, fmt.Errorf(
+					"container: %s: return %d: %w", bound.abiMethod.Name, i, adaptErr,
+				)
+			}
+
+			packed = append(packed, evmVal)
+
+			continue
+		}
+
+		packed = append(packed, retVal.Interface())
+	}
+
+	return bound.abiMethod.Outputs.Pack(packed...)
+}
+
+// lookupAdapter checks this Container's own registry before falling back to the package-wide
+// default registry.
+func (c *Container) lookupAdapter(goType reflect.Type) (TypeAdapter, bool) {
+	return resolveAdapter(c.adapters, goType)
+}
+
+// selectorOf copies a 4-byte ABI method selector out of id, which is abi.Method.ID.
+func selectorOf(id []byte) (sel [4]byte) {
+	copy(sel[:], id)
+
+	return sel
+}
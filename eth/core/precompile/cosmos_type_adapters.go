@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+)
+
+// cosmosCoin is the struct sdkCoinAdapter.ToEVM returns for abi.Arguments.Pack, which packs a
+// tuple argument by reading its fields by name via reflection rather than requiring a
+// specific struct type. It is NOT necessarily the type abi.Arguments.Unpack hands back for a
+// (string,uint256) tuple: the abi package builds its own anonymous struct type for that at
+// parse time, so sdkCoinAdapter.FromEVM reads that value's fields by reflection instead of
+// asserting to cosmosCoin.
+type cosmosCoin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+func init() {
+	RegisterTypeAdapter(reflect.TypeOf(sdk.Int{}), sdkIntAdapter{})
+	RegisterTypeAdapter(reflect.TypeOf(sdk.Coin{}), sdkCoinAdapter{})
+}
+
+// sdkIntAdapter adapts sdk.Int to/from a Solidity uint256.
+type sdkIntAdapter struct{}
+
+func (sdkIntAdapter) AbiType() abi.Type {
+	t, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+func (sdkIntAdapter) ToEVM(v reflect.Value) (interface{}, error) {
+	i, ok := v.Interface().(sdk.Int)
+	if !ok {
+		return nil, fmt.Errorf("sdkIntAdapter: expected sdk.Int, got %s", v.Type())
+	}
+
+	return i.BigInt(), nil
+}
+
+func (sdkIntAdapter) FromEVM(_ abi.Type, v interface{}) (reflect.Value, error) {
+	bi, ok := v.(*big.Int)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("sdkIntAdapter: expected *big.Int, got %T", v)
+	}
+
+	return reflect.ValueOf(sdk.NewIntFromBigInt(bi)), nil
+}
+
+// sdkCoinAdapter adapts sdk.Coin to/from a Solidity (string denom, uint256 amount) tuple.
+type sdkCoinAdapter struct{}
+
+func (sdkCoinAdapter) AbiType() abi.Type {
+	t, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "denom", Type: "string"},
+		{Name: "amount", Type: "uint256"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+func (sdkCoinAdapter) ToEVM(v reflect.Value) (interface{}, error) {
+	coin, ok := v.Interface().(sdk.Coin)
+	if !ok {
+		return nil, fmt.Errorf("sdkCoinAdapter: expected sdk.Coin, got %s", v.Type())
+	}
+
+	return cosmosCoin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}, nil
+}
+
+func (sdkCoinAdapter) FromEVM(_ abi.Type, v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf(
+			"sdkCoinAdapter: expected a (string, uint256) tuple struct, got %T", v,
+		)
+	}
+
+	denomField := rv.FieldByName("Denom")
+	amountField := rv.FieldByName("Amount")
+	if !denomField.IsValid() || !amountField.IsValid() {
+		return reflect.Value{}, fmt.Errorf(
+			"sdkCoinAdapter: expected fields Denom and Amount, got %s", rv.Type(),
+		)
+	}
+
+	denom, ok := denomField.Interface().(string)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf(
+			"sdkCoinAdapter: Denom field: expected string, got %s", denomField.Type(),
+		)
+	}
+
+	amount, ok := amountField.Interface().(*big.Int)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf(
+			"sdkCoinAdapter: Amount field: expected *big.Int, got %s", amountField.Type(),
+		)
+	}
+
+	return reflect.ValueOf(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount))), nil
+}
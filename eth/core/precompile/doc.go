@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package precompile binds Go implementations to EVM precompile ABIs.
+//
+// Binding a Go impl to an ABI happens in one of two ways:
+//
+//   - Generated (preferred): run precompilegen, typically via a go:generate directive next to
+//     the impl, e.g.:
+//
+//     //go:generate go run github.com/berachain/polaris/eth/core/precompile/generator/precompilegen -abi ./abi.json -type MyPrecompile -out mydispatcher.go
+//
+//     This emits a <Type>DispatchTable() function returning a table keyed by 4-byte selector
+//     pointing at typed thunks, with no reflection on the call path. See the generator
+//     package for details.
+//
+//   - Runtime reflection (fallback): findMatchingABIMethod/validateArg/validateStruct/
+//     validateOutputs in this package bind an impl to an ABI at runtime via reflection,
+//     case-insensitive name matching, and recursive struct comparison. This path remains
+//     available for impls that have not generated a dispatcher, but it is slower and should
+//     not be relied on for hot call paths.
+package precompile
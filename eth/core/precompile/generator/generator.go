@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package generator implements a go:generate-driven source generator for precompile ABI
+// dispatchers, in the spirit of source-mode mock generators like mockgen/moq but specialized
+// for binding an ABI to a typed Go dispatch table instead of an interface mock.
+//
+// Given an ABI JSON document and the name of the Go impl type that implements it, Generate
+// emits a single Go file containing:
+//   - a <TypeName>DispatchTable() function returning a table keyed by 4-byte selector,
+//     pointing at typed thunks
+//   - one thunk per ABI method that unpacks []interface{} into concrete Go args/structs and
+//     packs the typed return values back, with no reflection on the call path
+//   - a compile-time assertion that the impl type satisfies the generated interface
+//
+// The runtime, reflection-based path in the precompile package (findMatchingABIMethod, validateArg,
+// validateOutputs) remains available as a fallback for impls that have not generated a
+// dispatcher, but generated dispatchers should be preferred on any hot call path.
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+)
+
+// Config describes a single precompile dispatcher to generate.
+type Config struct {
+	// PackageName is the name of the package the generated file belongs to.
+	PackageName string
+	// TypeName is the name of the Go impl type the dispatcher calls into.
+	TypeName string
+	// RawABI is the raw ABI JSON the dispatcher is generated from. It is embedded verbatim
+	// in the generated file so the dispatcher can parse selectors and pack/unpack args
+	// without re-deriving them from Go types.
+	RawABI string
+	// ABI is the already-parsed form of RawABI.
+	ABI abi.ABI
+}
+
+// Generate renders the typed dispatcher source described by cfg.
+func Generate(cfg Config) ([]byte, error) {
+	names := make([]string, 0, len(cfg.ABI.Methods))
+	for name := range cfg.ABI.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	methods := make([]methodView, 0, len(names))
+	for _, name := range names {
+		abiMethod := cfg.ABI.Methods[name]
+
+		mv, err := buildMethodView(cfg.TypeName, abiMethod)
+		if err != nil {
+			return nil, fmt.Errorf("precompile %s, method %s: %w", cfg.TypeName, name, err)
+		}
+
+		methods = append(methods, mv)
+	}
+
+	var buf bytes.Buffer
+	if err := dispatcherTemplate.Execute(&buf, dispatcherView{
+		PackageName: cfg.PackageName,
+		TypeName:    cfg.TypeName,
+		RawABI:      cfg.RawABI,
+		Methods:     methods,
+	}); err != nil {
+		return nil, fmt.Errorf("executing dispatcher template: %w", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w\n%s", err, buf.String())
+	}
+
+	return formatted, nil
+}
+
+// methodView is the template-facing view of a single dispatched ABI method.
+type methodView struct {
+	// ThunkName is the exported Go identifier for the generated thunk, e.g. "Transfer".
+	ThunkName string
+	// ABIName is the solidity method name, e.g. "transfer".
+	ABIName string
+	// Args are the Go-typed arguments unpacked from the ABI input, in order.
+	Args []argView
+	// NumOutputs is the number of non-error values the impl method returns.
+	NumOutputs int
+	// ReturnType is the literal Go type of the impl method's single non-error return value,
+	// resolved via goType. It is empty when NumOutputs is 0, in which case the impl method
+	// returns only an error.
+	ReturnType string
+}
+
+// argView is the template-facing view of a single ABI argument or return value.
+type argView struct {
+	// GoName is the argument's local variable name inside the generated thunk.
+	GoName string
+	// GoType is the literal Go type the argument is asserted/coerced to, e.g. "*big.Int".
+	GoType string
+}
+
+// buildMethodView converts a parsed abi.Method into its generator-facing view, resolving each
+// solidity type to a concrete Go type via goType.
+func buildMethodView(typeName string, m abi.Method) (methodView, error) {
+	if len(m.Outputs) > 1 {
+		return methodView{}, fmt.Errorf(
+			"method %s has %d outputs: generated thunks only support 0 or 1 non-error "+
+				"return values today; bind this method via Container instead", m.Name, len(m.Outputs),
+		)
+	}
+
+	args := make([]argView, 0, len(m.Inputs))
+	for i, input := range m.Inputs {
+		goTyp, err := goType(input.Type)
+		if err != nil {
+			return methodView{}, err
+		}
+
+		name := input.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+
+		args = append(args, argView{
+			GoName: lowerFirst(toGoName(name)),
+			GoType: goTyp,
+		})
+	}
+
+	var returnType string
+	if len(m.Outputs) == 1 {
+		var err error
+		if returnType, err = goType(m.Outputs[0].Type); err != nil {
+			return methodView{}, err
+		}
+	}
+
+	return methodView{
+		ThunkName:  toGoName(m.Name),
+		ABIName:    m.Name,
+		Args:       args,
+		NumOutputs: len(m.Outputs),
+		ReturnType: returnType,
+	}, nil
+}
+
+// goType resolves a solidity ABI type to the literal Go type a generated thunk should
+// assert []interface{} elements returned from abi.Arguments.Unpack into.
+//
+// Tuples, including nested ones (a struct field that is itself a struct), map to anonymous
+// struct types via tupleGoType.
+func goType(t abi.Type) (string, error) {
+	switch t.T {
+	case abi.BoolTy:
+		return "bool", nil
+	case abi.StringTy:
+		return "string", nil
+	case abi.AddressTy:
+		return "common.Address", nil
+	case abi.HashTy:
+		return "common.Hash", nil
+	case abi.BytesTy:
+		return "[]byte", nil
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size), nil
+	case abi.UintTy, abi.IntTy:
+		return integerGoType(t), nil
+	case abi.SliceTy:
+		elemType, err := goType(*t.Elem)
+		if err != nil {
+			return "", err
+		}
+
+		return "[]" + elemType, nil
+	case abi.ArrayTy:
+		elemType, err := goType(*t.Elem)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("[%d]%s", t.Size, elemType), nil
+	case abi.TupleTy:
+		return tupleGoType(t)
+	default:
+		return "", fmt.Errorf("unsupported abi type %q for code generation", t.String())
+	}
+}
+
+// integerGoType maps a solidity uintN/intN to the Go integer type abi.Arguments.Unpack actually
+// hands back for it. geth's ABI decoder only special-cases the four standard widths (8, 16, 32,
+// 64); every other width - including the legal but non-standard 24, 40, 48, 56, and anything
+// above 64 - is decoded as *big.Int, so a type assertion to a sized int for those would fail at
+// runtime.
+func integerGoType(t abi.Type) string {
+	prefix := "uint"
+	if t.T == abi.IntTy {
+		prefix = "int"
+	}
+
+	switch t.Size {
+	case 8, 16, 32, 64:
+		return fmt.Sprintf("%s%d", prefix, t.Size)
+	default:
+		return "*big.Int"
+	}
+}
+
+// tupleGoType emits an anonymous Go struct literal matching the field names, order and json
+// tags the ABI library itself uses when it builds the tuple's reflect.Type, so a thunk's type
+// assertion against the value returned by abi.Arguments.Unpack succeeds structurally. Field
+// names are the raw names with the first letter upper-cased (see toGoName), which agrees with
+// the ABI library's own field names for raw names without underscores.
+func tupleGoType(t abi.Type) (string, error) {
+	var b strings.Builder
+	b.WriteString("struct{ ")
+
+	for i, elem := range t.TupleElems {
+		fieldType, err := goType(*elem)
+		if err != nil {
+			return "", err
+		}
+
+		rawName := t.TupleRawNames[i]
+		fmt.Fprintf(&b, "%s %s `json:%q`; ", toGoName(rawName), fieldType, rawName)
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
+
+// toGoName upper-cases the first letter of a solidity identifier so it reads as an exported Go
+// identifier, e.g. "recipient" -> "Recipient".
+func toGoName(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// lowerFirst lower-cases the first letter of s, e.g. "Recipient" -> "recipient".
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
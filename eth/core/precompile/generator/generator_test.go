@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package generator_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+	"github.com/berachain/polaris/eth/core/precompile/generator"
+)
+
+// updateGolden regenerates the golden files this test compares against, analogous to the
+// -update flag supported by moq and mockgen's own golden-file test suites.
+var updateGolden = flag.Bool("update", false, "update golden files instead of comparing against them")
+
+func TestGenerate_MockToken(t *testing.T) {
+	rawABI, err := os.ReadFile(filepath.Join("testdata", "mock.abi.json"))
+	if err != nil {
+		t.Fatalf("reading fixture ABI: %v", err)
+	}
+
+	parsedABI, err := abi.JSON(bytes.NewReader(rawABI))
+	if err != nil {
+		t.Fatalf("parsing fixture ABI: %v", err)
+	}
+
+	got, err := generator.Generate(generator.Config{
+		PackageName: "mocktoken",
+		TypeName:    "MockToken",
+		RawABI:      string(rawABI),
+		ABI:         parsedABI,
+	})
+	if err != nil {
+		t.Fatalf("Generate() returned an error: %v", err)
+	}
+
+	goldenPath := filepath.Join("testdata", "mocktoken.golden.go")
+
+	if *updateGolden {
+		want := bytes.Replace(got, []byte(string(rawABI)), []byte("RAW_ABI_PLACEHOLDER"), 1)
+		if err = os.WriteFile(goldenPath, want, 0o644); err != nil {
+			t.Fatalf("writing golden file: %v", err)
+		}
+
+		return
+	}
+
+	wantTemplate, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+
+	want := bytes.Replace(wantTemplate, []byte("RAW_ABI_PLACEHOLDER"), []byte(string(rawABI)), 1)
+
+	if !bytes.Equal(got, want) {
+		t.Errorf(
+			"generated dispatcher does not match golden file %s; rerun with -update if the "+
+				"change is intentional\n--- got ---\n%s\n--- want ---\n%s",
+			goldenPath, got, want,
+		)
+	}
+}
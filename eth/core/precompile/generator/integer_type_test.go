@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package generator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+)
+
+// TestIntegerGoType checks that only the widths geth's ABI decoder special-cases (8, 16, 32,
+// 64) map to a sized Go int; every other width - including legal-but-non-standard ones like 24
+// and 40 - must fall back to *big.Int, since that's what abi.Arguments.Unpack actually returns
+// for them.
+func TestIntegerGoType(t *testing.T) {
+	tests := []struct {
+		size int
+		want string
+	}{
+		{size: 8, want: "uint8"},
+		{size: 16, want: "uint16"},
+		{size: 32, want: "uint32"},
+		{size: 64, want: "uint64"},
+		{size: 24, want: "*big.Int"},
+		{size: 40, want: "*big.Int"},
+		{size: 48, want: "*big.Int"},
+		{size: 56, want: "*big.Int"},
+		{size: 128, want: "*big.Int"},
+		{size: 256, want: "*big.Int"},
+	}
+
+	for _, tt := range tests {
+		typ, err := abi.NewType(fmt.Sprintf("uint%d", tt.size), "", nil)
+		if err != nil {
+			t.Fatalf("uint%d: %v", tt.size, err)
+		}
+
+		if got := integerGoType(typ); got != tt.want {
+			t.Errorf("integerGoType(uint%d) = %s, want %s", tt.size, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package mocktoken is a real (non-testdata) package pairing a hand-written precompile impl
+// with its precompilegen-generated dispatcher, so that generator_compile_test.go's comparison against
+// mocktoken_generated.go is backed by an ordinary `go build`/`go test` of this package instead
+// of a golden file living under testdata/, which the Go toolchain never compiles.
+package mocktoken
+
+//go:generate go run github.com/berachain/polaris/eth/core/precompile/generator/precompilegen -abi ./mocktoken.abi.json -type MockToken -out mocktoken_generated.go
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MockToken is a minimal in-memory token impl satisfying MockTokenMethods.
+type MockToken struct {
+	balances map[common.Address]*big.Int
+}
+
+func (m *MockToken) BalanceOf(_ context.Context, account common.Address) (*big.Int, error) {
+	if bal, ok := m.balances[account]; ok {
+		return bal, nil
+	}
+
+	return big.NewInt(0), nil
+}
+
+func (m *MockToken) Transfer(_ context.Context, _ common.Address, _ *big.Int) (bool, error) {
+	return true, nil
+}
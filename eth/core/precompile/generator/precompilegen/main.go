@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Command precompilegen is a go:generate-driven source generator that binds a precompile ABI
+// to a typed Go dispatch table. See the parent generator package for the codegen itself; this
+// command is a thin CLI wrapper intended to be invoked via a go:generate directive, e.g.:
+//
+//	//go:generate go run github.com/berachain/polaris/eth/core/precompile/generator/precompilegen -abi ./abi.json -type MyPrecompile -out mydispatcher.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+	"github.com/berachain/polaris/eth/core/precompile/generator"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "precompilegen:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var (
+		abiPath string
+		typeName string
+		pkgName string
+		outPath string
+	)
+
+	flag.StringVar(&abiPath, "abi", "", "path to the precompile's ABI JSON file")
+	flag.StringVar(&typeName, "type", "", "name of the Go impl type the dispatcher calls into")
+	flag.StringVar(&pkgName, "pkg", "", "package name for the generated file (default: $GOPACKAGE)")
+	flag.StringVar(&outPath, "out", "", "output file path")
+	flag.Parse()
+
+	if abiPath == "" || typeName == "" || outPath == "" {
+		flag.Usage()
+		return fmt.Errorf("missing required flag: -abi, -type, and -out are all required")
+	}
+
+	if pkgName == "" {
+		pkgName = os.Getenv("GOPACKAGE")
+	}
+	if pkgName == "" {
+		return fmt.Errorf("-pkg not set and $GOPACKAGE is empty; run via go:generate or pass -pkg")
+	}
+
+	rawABI, err := os.ReadFile(abiPath)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", abiPath, err)
+	}
+
+	parsedABI, err := abi.JSON(bytes.NewReader(rawABI))
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", abiPath, err)
+	}
+
+	src, err := generator.Generate(generator.Config{
+		PackageName: pkgName,
+		TypeName:    typeName,
+		RawABI:      string(rawABI),
+		ABI:         parsedABI,
+	})
+	if err != nil {
+		return fmt.Errorf("generating dispatcher for %s: %w", typeName, err)
+	}
+
+	if err = os.WriteFile(outPath, src, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", outPath, err)
+	}
+
+	return nil
+}
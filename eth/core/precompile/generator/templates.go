@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package generator
+
+import "text/template"
+
+// dispatcherView is the root template data for dispatcherTemplate.
+type dispatcherView struct {
+	PackageName string
+	TypeName    string
+	RawABI      string
+	Methods     []methodView
+}
+
+var dispatcherTemplate = template.Must(template.New("dispatcher").Parse(`// Code generated by precompilegen. DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// {{.TypeName}}ABI is the parsed ABI {{.TypeName}}'s dispatcher was generated from.
+var {{.TypeName}}ABI = abi.MustUnmarshalJSON([]byte(` + "`" + `{{.RawABI}}` + "`" + `))
+
+// {{.TypeName}}Methods is the set of methods {{.TypeName}} must implement for its generated
+// dispatcher to type-check. Each method returns at most one non-error value: the generator
+// rejects an ABI with a method that has more than one output.
+type {{.TypeName}}Methods interface {
+{{- range .Methods}}
+{{- if eq .NumOutputs 0}}
+	{{.ThunkName}}(ctx context.Context{{range .Args}}, {{.GoName}} {{.GoType}}{{end}}) error
+{{- else}}
+	{{.ThunkName}}(ctx context.Context{{range .Args}}, {{.GoName}} {{.GoType}}{{end}}) ({{.ReturnType}}, error)
+{{- end}}
+{{- end}}
+}
+
+var _ {{.TypeName}}Methods = (*{{.TypeName}})(nil)
+
+// {{.TypeName}}Thunk dispatches a single precompile call to a typed {{.TypeName}} method.
+type {{.TypeName}}Thunk func(impl *{{.TypeName}}, ctx context.Context, input []byte) ([]byte, error)
+
+// {{.TypeName}}DispatchTable returns the dispatch table used to route a call's 4-byte
+// selector directly to its generated thunk, with no reflection on the call path.
+func {{.TypeName}}DispatchTable() map[[4]byte]{{.TypeName}}Thunk {
+	table := make(map[[4]byte]{{.TypeName}}Thunk, {{len .Methods}})
+{{range .Methods}}
+	table[selectorOf({{$.TypeName}}ABI.Methods["{{.ABIName}}"].ID)] = thunk{{$.TypeName}}{{.ThunkName}}
+{{- end}}
+
+	return table
+}
+
+func selectorOf(id []byte) (sel [4]byte) {
+	copy(sel[:], id)
+
+	return sel
+}
+{{range .Methods}}{{$m := .}}
+// thunk{{$.TypeName}}{{$m.ThunkName}} unpacks input into {{$m.ABIName}}'s concrete Go argument
+// types and invokes {{$.TypeName}}.{{$m.ThunkName}} with no reflection.
+func thunk{{$.TypeName}}{{$m.ThunkName}}(impl *{{$.TypeName}}, ctx context.Context, input []byte) ([]byte, error) {
+	abiMethod := {{$.TypeName}}ABI.Methods["{{$m.ABIName}}"]
+
+	unpacked, err := abiMethod.Inputs.Unpack(input)
+	if err != nil {
+		return nil, err
+	}
+{{range $i, $a := .Args}}
+	{{$a.GoName}}, ok := unpacked[{{$i}}].({{$a.GoType}})
+	if !ok {
+		return nil, fmt.Errorf("{{$.TypeName}}.{{$m.ThunkName}}: arg %d: type assertion to {{$a.GoType}} failed", {{$i}})
+	}
+{{end}}
+{{if eq .NumOutputs 0}}
+	err = impl.{{.ThunkName}}(ctx{{range .Args}}, {{.GoName}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+
+	return abiMethod.Outputs.Pack()
+{{else}}
+	ret, err := impl.{{.ThunkName}}(ctx{{range .Args}}, {{.GoName}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+
+	return abiMethod.Outputs.Pack(ret)
+{{end}}
+}
+{{end}}
+`))
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package precompile
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+)
+
+// Bindable is the constraint a generic precompile impl's type parameter must satisfy to
+// register with NewGenericContainer. It places no real requirement on T today, but gives
+// callers a single named constraint to reference (e.g. `type Handler[T Bindable] struct{...}`)
+// instead of `any`, so a future requirement (e.g. implementing TypeAdapter) can be added here
+// without changing every generic precompile impl's signature.
+type Bindable interface {
+	any
+}
+
+// NewGenericContainer builds a Container for a generic precompile impl whose methods are
+// parameterized over T, e.g.:
+//
+//	type Handler[T Bindable] struct{ ... }
+//	func (h *Handler[T]) Create(ctx context.Context, v T) (common.Address, error)
+//
+// Go monomorphizes each generic instantiation (Handler[Foo], Handler[Bar], ...) into its own
+// concrete type, so by the time NewContainer sees impl's methods, T has already been resolved
+// to a concrete Go type and findMatchingABIMethod/validateArg work unchanged. NewGenericContainer
+// exists to make that instantiation explicit at the call site: it resolves T's reflect.Type via
+// reflect.TypeOf((*T)(nil)).Elem() and checks impl was actually instantiated with T, so the same
+// generic impl can be registered more than once - e.g. Handler[Foo] at one precompile address and
+// Handler[Bar] at another - without the two instantiations being mixed up.
+func NewGenericContainer[T Bindable](
+	impl interface{}, abiMethods map[string]abi.Method, opts ...ContainerOption,
+) (*Container, error) {
+	implType := reflect.TypeOf(impl)
+	if implType.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("precompile: generic impl must be a pointer, got %s", implType)
+	}
+
+	tType := reflect.TypeOf((*T)(nil)).Elem()
+	if err := verifyGenericInstantiation(implType.Elem(), tType); err != nil {
+		return nil, err
+	}
+
+	return NewContainer(impl, abiMethods, opts...)
+}
+
+// verifyGenericInstantiation checks that implElemType looks like it was instantiated with
+// tType: a generic instantiation's reflect.Type.String() includes its type argument's name,
+// qualified by its full import path, inside square brackets (e.g.
+// "precompile.Handler[github.com/berachain/polaris/eth/core/precompile.fooType]"), so tType's
+// qualified name, wrapped in the same brackets, should appear in it. The brackets matter:
+// without them, a type parameter whose name is a substring of another's (Foo vs FooBar) would
+// incorrectly verify.
+func verifyGenericInstantiation(implElemType, tType reflect.Type) error {
+	tName := tType.String()
+	if tType.PkgPath() != "" && tType.Name() != "" {
+		tName = tType.PkgPath() + "." + tType.Name()
+	}
+
+	if !strings.Contains(implElemType.String(), "["+tName+"]") {
+		return fmt.Errorf(
+			"precompile: %s does not appear to be instantiated with type parameter %s",
+			implElemType, tType,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package precompile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/berachain/polaris/contracts/bindings/testing"
+	"github.com/berachain/polaris/eth/accounts/abi"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fooHandled and barHandled are two distinct Bindable types registered against the same
+// `create((string))` ABI under two different Handler instantiations.
+type fooHandled struct {
+	Value string
+}
+
+type barHandled struct {
+	Value string
+}
+
+// fooHandledExtra's name contains fooHandled's as a substring, to guard against
+// verifyGenericInstantiation accepting a mismatched type parameter on a loose substring match.
+type fooHandledExtra struct {
+	Value string
+}
+
+// Handler is a generic precompile impl: the same Create method is usable with any Bindable T.
+// Create derives its return value from v so a dispatch test can tell which instantiation
+// actually handled a call.
+type Handler[T Bindable] struct{}
+
+func (h *Handler[T]) Create(_ context.Context, v T) (common.Address, error) {
+	return common.BytesToAddress([]byte(fmt.Sprintf("%v", v))), nil
+}
+
+var _ = Describe("NewGenericContainer", func() {
+	var createABI map[string]abi.Method
+
+	BeforeEach(func() {
+		createABI = abi.MustUnmarshalJSON(testing.MockMethodsABI).Methods
+	})
+
+	It("registers Handler[fooHandled] and Handler[barHandled] independently", func() {
+		fooContainer, err := NewGenericContainer[fooHandled](&Handler[fooHandled]{}, createABI)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fooContainer).ToNot(BeNil())
+
+		barContainer, err := NewGenericContainer[barHandled](&Handler[barHandled]{}, createABI)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(barContainer).ToNot(BeNil())
+
+		Expect(fooContainer).ToNot(BeIdenticalTo(barContainer))
+	})
+
+	It("rejects a type parameter that doesn't match the impl's instantiation", func() {
+		_, err := NewGenericContainer[barHandled](&Handler[fooHandled]{}, createABI)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects a type parameter whose name is merely a substring of the impl's", func() {
+		_, err := NewGenericContainer[fooHandled](&Handler[fooHandledExtra]{}, createABI)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("dispatches Create to the matching instantiation's own T", func() {
+		fooContainer, err := NewGenericContainer[fooHandled](&Handler[fooHandled]{}, createABI)
+		Expect(err).ToNot(HaveOccurred())
+
+		barContainer, err := NewGenericContainer[barHandled](&Handler[barHandled]{}, createABI)
+		Expect(err).ToNot(HaveOccurred())
+
+		create := createABI["create"]
+		selector := selectorOf(create.ID)
+
+		fooInput, err := create.Inputs.Pack(fooHandled{Value: "foo-value"})
+		Expect(err).ToNot(HaveOccurred())
+
+		barInput, err := create.Inputs.Pack(barHandled{Value: "bar-value"})
+		Expect(err).ToNot(HaveOccurred())
+
+		fooOut, err := fooContainer.Call(context.Background(), selector, fooInput)
+		Expect(err).ToNot(HaveOccurred())
+
+		barOut, err := barContainer.Call(context.Background(), selector, barInput)
+		Expect(err).ToNot(HaveOccurred())
+
+		fooUnpacked, err := create.Outputs.Unpack(fooOut)
+		Expect(err).ToNot(HaveOccurred())
+
+		barUnpacked, err := create.Outputs.Unpack(barOut)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fooUnpacked[0]).To(Equal(common.BytesToAddress([]byte(fmt.Sprintf("%v", fooHandled{Value: "foo-value"})))))
+		Expect(barUnpacked[0]).To(Equal(common.BytesToAddress([]byte(fmt.Sprintf("%v", barHandled{Value: "bar-value"})))))
+		Expect(fooUnpacked[0]).ToNot(Equal(barUnpacked[0]))
+	})
+})
@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+)
+
+// errorType is the reflected type of the error interface, used to verify that every
+// precompile impl method returns an error as its last return value.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ErrAmbiguousOverload is returned by findMatchingABIMethod when an impl method's name
+// substring-matches more than one overload of the same Solidity function and its signature
+// validates against more than one of them, so the intended overload can't be inferred.
+var ErrAmbiguousOverload = errors.New("precompile: ambiguous overload")
+
+// findMatchingABIMethod attempts to find the ABI method that corresponds to the given impl
+// method.
+//
+// It first looks for an exact, case-insensitive name match. This also covers Solidity function
+// overloading: the ABI parser assigns an overload's first occurrence its bare name (e.g.
+// "transfer") and suffixes subsequent occurrences with an index (e.g. "transfer0",
+// "transfer1", ...), so a Go method binds to a specific overload by following the same naming
+// convention (e.g. Transfer, Transfer0, Transfer1). If an exact name match is found but the
+// impl method's signature doesn't validate against it, that mismatch is returned as an error
+// rather than falling through, since an exact name match signals an intentional binding.
+//
+// Failing an exact match, it falls back to a case-insensitive substring match, as before. If
+// more than one ABI method name is a substring match (e.g. several overloads of the same
+// function), the impl method's signature is checked against each candidate via validateMethod
+// to disambiguate. It returns the empty string, with a nil error, if no ABI method matches at
+// all, and ErrAmbiguousOverload if more than one candidate's signature validates.
+//
+// adapters is consulted by validateArg/validateStruct ahead of the package-wide default
+// registry; pass nil to check the default registry only.
+func findMatchingABIMethod(
+	implMethod reflect.Method, abiMethods map[string]abi.Method, adapters *AdapterRegistry,
+) (string, error) {
+	for name, abiMethod := range abiMethods {
+		if !strings.EqualFold(implMethod.Name, name) {
+			continue
+		}
+
+		if err := validateMethod(implMethod, &abiMethod, adapters); err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+
+		return name, nil
+	}
+
+	implName := strings.ToLower(implMethod.Name)
+
+	candidates := make([]string, 0, len(abiMethods))
+	for name := range abiMethods {
+		if strings.Contains(implName, strings.ToLower(name)) {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+
+	var matched []string
+	for _, name := range candidates {
+		abiMethod := abiMethods[name]
+		if err := validateMethod(implMethod, &abiMethod, adapters); err == nil {
+			matched = append(matched, name)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return "", nil
+	case 1:
+		return matched[0], nil
+	default:
+		return "", fmt.Errorf(
+			"%w: %s matches overloads [%s]",
+			ErrAmbiguousOverload, implMethod.Name, strings.Join(matched, ", "),
+		)
+	}
+}
+
+// validateMethod checks that the impl method's inputs and outputs are compatible with the
+// given ABI method.
+//
+// adapters is the container-local registry to consult ahead of the package-wide default (see
+// resolveAdapter); pass nil when validating outside of a Container (e.g. the package-level
+// RegisterTypeAdapter path).
+func validateMethod(
+	implMethod reflect.Method, abiMethod *abi.Method, adapters *AdapterRegistry,
+) error {
+	// implMethod.Type.In(0) is the receiver and In(1) is the context.Context, so the first
+	// "real" input starts at index 2.
+	const firstArgOffset = 2
+
+	implType := implMethod.Func.Type()
+	if implType.NumIn()-firstArgOffset != len(abiMethod.Inputs) {
+		return fmt.Errorf(
+			"number of args mismatch: %s expects %d args, %s has %d args",
+			abiMethod.Name, len(abiMethod.Inputs), implMethod.Name,
+			implType.NumIn()-firstArgOffset,
+		)
+	}
+
+	for i, input := range abiMethod.Inputs {
+		if err := validateArg(
+			reflect.New(implType.In(i+firstArgOffset)).Elem(),
+			reflect.New(input.Type.GetType()).Elem(),
+			adapters,
+		); err != nil {
+			return err
+		}
+	}
+
+	return validateOutputs(implMethod, abiMethod)
+}
+
+// validateArg compares the reflected type of an impl method argument against the reflected
+// type of the corresponding ABI argument, recursing into slice/array element types and struct
+// field types as necessary.
+//
+// If a TypeAdapter is registered for the impl argument's Go type - in adapters, a
+// container-local registry, or else the package-wide default (see resolveAdapter) - the ABI
+// side is checked against the adapter's AbiType instead of requiring an exact reflect-type
+// correspondence.
+func validateArg(implVal, abiVal reflect.Value, adapters *AdapterRegistry) error {
+	implType := implVal.Type()
+	abiType := abiVal.Type()
+
+	if adapter, ok := resolveAdapter(adapters, implType); ok {
+		if abiType != adapter.AbiType().GetType() {
+			return fmt.Errorf("type mismatch: %s != %s", implType, abiType)
+		}
+
+		return nil
+	}
+
+	if implType.Kind() != abiType.Kind() {
+		return fmt.Errorf("type mismatch: %s != %s", implType, abiType)
+	}
+
+	switch implType.Kind() {
+	case reflect.Slice, reflect.Array:
+		implElem := implType.Elem()
+		abiElem := abiType.Elem()
+
+		if implElem.Kind() == reflect.Struct && abiElem.Kind() == reflect.Struct {
+			return validateStruct(implElem, abiElem, adapters)
+		}
+
+		if implElem != abiElem {
+			return fmt.Errorf("type mismatch: %s != %s", implType, abiType)
+		}
+
+		return nil
+	case reflect.Struct:
+		return validateStruct(implType, abiType, adapters)
+	default:
+		if implType != abiType {
+			return fmt.Errorf("type mismatch: %s != %s", implType, abiType)
+		}
+
+		return nil
+	}
+}
+
+// validateStruct compares the fields of two struct types, in order, using validateArg on each
+// pair of fields.
+func validateStruct(implType, abiType reflect.Type, adapters *AdapterRegistry) error {
+	if implType.Kind() != reflect.Struct || abiType.Kind() != reflect.Struct {
+		return fmt.Errorf("validateStruct: not a struct")
+	}
+
+	if implType.NumField() != abiType.NumField() {
+		return fmt.Errorf(
+			"validateStruct: mismatched number of fields: %s has %d, %s has %d",
+			implType, implType.NumField(), abiType, abiType.NumField(),
+		)
+	}
+
+	for i := 0; i < implType.NumField(); i++ {
+		if err := validateArg(
+			reflect.New(implType.Field(i).Type).Elem(),
+			reflect.New(abiType.Field(i).Type).Elem(),
+			adapters,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateOutputs checks that the impl method's return values are compatible with the given
+// ABI method's outputs. The impl method must return an error as its last value, and its
+// remaining return values must match the ABI method's output types, one by one and in order.
+func validateOutputs(implMethod reflect.Method, abiMethod *abi.Method) error {
+	implType := implMethod.Func.Type()
+	numOut := implType.NumOut()
+
+	lastOut := implType.Out(numOut - 1)
+	if lastOut != errorType {
+		return fmt.Errorf("last return type must be error, got %s", lastOut)
+	}
+
+	implNumReturns := numOut - 1
+	if implNumReturns != len(abiMethod.Outputs) {
+		return fmt.Errorf(
+			"number of return args mismatch: %s expects %d return vals, %s returns %d vals",
+			abiMethod.Name, len(abiMethod.Outputs), implMethod.Name, implNumReturns,
+		)
+	}
+
+	for i, output := range abiMethod.Outputs {
+		abiOutputType := output.Type.GetType()
+		implOutputType := implType.Out(i)
+		if implOutputType != abiOutputType {
+			return fmt.Errorf(
+				"return type mismatch: %s expects %s, %s has %s",
+				abiMethod.Name, abiOutputType, implMethod.Name, implOutputType,
+			)
+		}
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ package precompile
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"reflect"
 
@@ -49,7 +50,7 @@ var _ = Describe("Method", func() {
 			exampleFuncValue, found := reflect.TypeOf(m).MethodByName("ExampleFunc")
 			Expect(found).To(BeTrue())
 
-			methodName, err := findMatchingABIMethod(exampleFuncValue, precompileABI)
+			methodName, err := findMatchingABIMethod(exampleFuncValue, precompileABI, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(methodName).To(Equal("exampleFunc"))
 
@@ -58,7 +59,7 @@ var _ = Describe("Method", func() {
 
 			Expect(validateArg(
 				reflect.ValueOf(sliceA),
-				reflect.ValueOf(sliceB)).Error()).To(Equal(
+				reflect.ValueOf(sliceB), nil).Error()).To(Equal(
 				"type mismatch: []uint64 != []*big.Int",
 			))
 		})
@@ -70,7 +71,7 @@ var _ = Describe("Method", func() {
 			sliceB := uint64(0)
 			Expect(validateArg(
 				reflect.ValueOf(sliceA),
-				reflect.ValueOf(sliceB)).Error()).To(Equal(
+				reflect.ValueOf(sliceB), nil).Error()).To(Equal(
 				"type mismatch: []uint64 != uint64",
 			))
 		})
@@ -79,7 +80,7 @@ var _ = Describe("Method", func() {
 			sliceB := []mockStructBad{}
 			Expect(validateArg(
 				reflect.ValueOf(sliceA),
-				reflect.ValueOf(sliceB)).Error()).To(Equal(
+				reflect.ValueOf(sliceB), nil).Error()).To(Equal(
 				"type mismatch: *big.Int != uint64",
 			))
 		})
@@ -89,18 +90,17 @@ var _ = Describe("Method", func() {
 			abiMethodVarType := &mockStruct{}
 			Expect(validateArg(
 				reflect.ValueOf(implMethodVarType).Elem(),
-				reflect.ValueOf(abiMethodVarType)).Error()).To(Equal(
+				reflect.ValueOf(abiMethodVarType), nil).Error()).To(Equal(
 				"type mismatch: int != *precompile.mockStruct",
 			))
 		})
 	})
 
-	It("should panic when our ABI method does not return anything", func() {
+	It("should validate successfully when our ABI method does not return anything", func() {
 		zeroReturn := precompileABI["zeroReturn"]
 		mockMethod, _ := reflect.TypeOf(m).MethodByName("MockMethod")
 
-		//nolint:errcheck // it's going to panic
-		Expect(func() { validateOutputs(mockMethod, &zeroReturn) }).To(Panic())
+		Expect(validateOutputs(mockMethod, &zeroReturn)).ToNot(HaveOccurred())
 	})
 	It("should error when we have different structs as params", func() {
 		m := mockStruct{}
@@ -108,16 +108,16 @@ var _ = Describe("Method", func() {
 
 		Expect(validateArg(
 			reflect.New(reflect.TypeOf(m)).Elem(),
-			reflect.New(reflect.TypeOf(mb)).Elem())).To(HaveOccurred())
+			reflect.New(reflect.TypeOf(mb)).Elem(), nil)).To(HaveOccurred())
 
-		Expect(validateStruct(reflect.TypeOf(m), reflect.TypeOf(mb))).To(HaveOccurred())
+		Expect(validateStruct(reflect.TypeOf(m), reflect.TypeOf(mb), nil)).To(HaveOccurred())
 		mbn := mockStructBadNumFields{}
 
-		Expect(validateStruct(reflect.TypeOf(m), reflect.TypeOf(mbn))).To(HaveOccurred())
+		Expect(validateStruct(reflect.TypeOf(m), reflect.TypeOf(mbn), nil)).To(HaveOccurred())
 
 		notAStruct := 69
 
-		Expect(validateStruct(reflect.TypeOf(m), reflect.TypeOf(notAStruct)).Error()).To(Equal(
+		Expect(validateStruct(reflect.TypeOf(m), reflect.TypeOf(notAStruct), nil).Error()).To(Equal(
 			"validateStruct: not a struct"))
 	})
 
@@ -141,6 +141,19 @@ var _ = Describe("Method", func() {
 			Expect(validateOutputs(returnTypeMismatch, &exampleFunc).Error()).To(Equal(
 				"return type mismatch: exampleFunc expects bool, ReturnTypeMismatch has string"))
 		})
+
+		It("should validate every output, not just the first", func() {
+			multiOutput := precompileABI["multiOutput"]
+
+			good, found := reflect.TypeOf(m).MethodByName("MultiOutput")
+			Expect(found).To(BeTrue())
+			Expect(validateOutputs(good, &multiOutput)).ToNot(HaveOccurred())
+
+			bad, found := reflect.TypeOf(m).MethodByName("MultiOutputBad")
+			Expect(found).To(BeTrue())
+			Expect(validateOutputs(bad, &multiOutput).Error()).To(Equal(
+				"return type mismatch: multiOutput expects *big.Int, MultiOutputBad has string"))
+		})
 	})
 
 	Context("findMatchingABIMethod", func() {
@@ -148,10 +161,52 @@ var _ = Describe("Method", func() {
 		It("should return ErrNoImplMethodSubstringMatchesABIMethods", func() {
 			mockMethod, found := reflect.TypeOf(m).MethodByName("MockMethod")
 			Expect(found).To(BeTrue())
-			methodName, err := findMatchingABIMethod(mockMethod, precompileABI)
+			methodName, err := findMatchingABIMethod(mockMethod, precompileABI, nil)
 			Expect(methodName).To(Equal(""))
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		Context("overloaded Solidity functions", func() {
+			var mt *mockTransferImpl
+			BeforeEach(func() {
+				mt = &mockTransferImpl{}
+			})
+
+			It("binds the first overload by its bare name", func() {
+				transfer, found := reflect.TypeOf(mt).MethodByName("Transfer")
+				Expect(found).To(BeTrue())
+
+				methodName, err := findMatchingABIMethod(transfer, precompileABI, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(methodName).To(Equal("transfer"))
+			})
+
+			It("binds a later overload via the ...0, ...1 naming convention", func() {
+				transfer0, found := reflect.TypeOf(mt).MethodByName("Transfer0")
+				Expect(found).To(BeTrue())
+
+				methodName, err := findMatchingABIMethod(transfer0, precompileABI, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(methodName).To(Equal("transfer0"))
+			})
+
+			It("errors instead of falling back when an exact name match has the wrong signature", func() {
+				mb := &mockTransferBadImpl{}
+				transfer, found := reflect.TypeOf(mb).MethodByName("Transfer")
+				Expect(found).To(BeTrue())
+
+				_, err := findMatchingABIMethod(transfer, precompileABI, nil)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("returns ErrAmbiguousOverload when more than one candidate validates", func() {
+				doFooBar, found := reflect.TypeOf(mt).MethodByName("DoFooBar")
+				Expect(found).To(BeTrue())
+
+				_, err := findMatchingABIMethod(doFooBar, precompileABI, nil)
+				Expect(errors.Is(err, ErrAmbiguousOverload)).To(BeTrue())
+			})
+		})
 	})
 })
 
@@ -201,3 +256,42 @@ func (m *mockImpl) NumReturnMismatch(_ context.Context, _ *big.Int) error {
 func (m *mockImpl) ReturnTypeMismatch(context.Context, *big.Int) (string, error) {
 	return "bera", nil
 }
+
+func (m *mockImpl) MultiOutput(context.Context) (bool, *big.Int, error) {
+	return true, big.NewInt(0), nil
+}
+
+func (m *mockImpl) MultiOutputBad(context.Context) (bool, string, error) {
+	return true, "bera", nil
+}
+
+// mockTransferImpl exercises findMatchingABIMethod's overload resolution against the
+// overloaded `transfer` fixture (and the deliberately ambiguous `foo`/`foobar` fixture) in
+// testing.MockMethodsABI.
+type mockTransferImpl struct{}
+
+func (m *mockTransferImpl) Transfer(
+	_ context.Context, _ common.Address, _ *big.Int,
+) (bool, error) {
+	return true, nil
+}
+
+func (m *mockTransferImpl) Transfer0(
+	_ context.Context, _ common.Address, _ *big.Int, _ []byte,
+) (bool, error) {
+	return true, nil
+}
+
+func (m *mockTransferImpl) DoFooBar(
+	_ context.Context, _ *big.Int, _ common.Address,
+) (bool, error) {
+	return true, nil
+}
+
+// mockTransferBadImpl binds the `transfer` name exactly but with the wrong signature, to
+// exercise the "exact match found, signature invalid" error path.
+type mockTransferBadImpl struct{}
+
+func (m *mockTransferBadImpl) Transfer(_ context.Context, _ common.Address) (bool, error) {
+	return true, nil
+}
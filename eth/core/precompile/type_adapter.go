@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package precompile
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/berachain/polaris/eth/accounts/abi"
+)
+
+// TypeAdapter lets a Go type stand in for a different Solidity ABI type on a precompile impl
+// method, instead of requiring an exact reflect-type correspondence between the two. ToEVM
+// converts a Go value of the adapter's type into the value abi.Arguments.Pack expects for
+// AbiType; FromEVM does the reverse, converting a value unpacked by abi.Arguments.Unpack back
+// into the adapter's Go type.
+type TypeAdapter interface {
+	// AbiType is the Solidity ABI type the adapted Go type is checked and packed/unpacked as.
+	AbiType() abi.Type
+	// ToEVM converts a reflected Go value of the adapter's type into a value suitable for
+	// abi.Arguments.Pack.
+	ToEVM(reflect.Value) (interface{}, error)
+	// FromEVM converts a value produced by abi.Arguments.Unpack back into the adapter's Go
+	// type.
+	FromEVM(abiType abi.Type, v interface{}) (reflect.Value, error)
+}
+
+// AdapterRegistry is a concurrency-safe set of TypeAdapters keyed by the Go type they adapt.
+type AdapterRegistry struct {
+	mu       sync.RWMutex
+	adapters map[reflect.Type]TypeAdapter
+}
+
+// NewAdapterRegistry returns an empty AdapterRegistry.
+func NewAdapterRegistry() *AdapterRegistry {
+	return &AdapterRegistry{
+		adapters: make(map[reflect.Type]TypeAdapter),
+	}
+}
+
+// Register associates adapter with goType, overwriting any adapter previously registered for
+// that type.
+func (r *AdapterRegistry) Register(goType reflect.Type, adapter TypeAdapter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.adapters[goType] = adapter
+}
+
+// Lookup returns the adapter registered for goType, if any.
+func (r *AdapterRegistry) Lookup(goType reflect.Type) (TypeAdapter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	adapter, ok := r.adapters[goType]
+
+	return adapter, ok
+}
+
+// defaultAdapters is the registry consulted by the package-level validateArg/validateStruct
+// helpers, so that code binding a precompile without going through a Container (e.g. the
+// tests in this package) still benefits from adapters registered via RegisterTypeAdapter. A
+// Container keeps its own registry for the adapters it uses at call time; RegisterTypeAdapter
+// registers globally and is the right choice for adapters for common domain types (see
+// cosmos_type_adapters.go) that every precompile should be able to rely on.
+var defaultAdapters = NewAdapterRegistry()
+
+// RegisterTypeAdapter registers adapter for goType on the package-wide default registry.
+func RegisterTypeAdapter(goType reflect.Type, adapter TypeAdapter) {
+	defaultAdapters.Register(goType, adapter)
+}
+
+// resolveAdapter looks up goType in local, if non-nil, falling back to defaultAdapters. local
+// is a container-local registry (see Container.adapters); passing nil checks the default
+// registry only, which is the right behavior for validation done outside of a Container.
+func resolveAdapter(local *AdapterRegistry, goType reflect.Type) (TypeAdapter, bool) {
+	if local != nil {
+		if adapter, ok := local.Lookup(goType); ok {
+			return adapter, true
+		}
+	}
+
+	return defaultAdapters.Lookup(goType)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package precompile
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+
+	"github.com/berachain/polaris/contracts/bindings/testing"
+	"github.com/berachain/polaris/eth/accounts/abi"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TypeAdapter", func() {
+	var sendCoinABI map[string]abi.Method
+
+	BeforeEach(func() {
+		sendCoinABI = abi.MustUnmarshalJSON(testing.MockMethodsABI).Methods
+	})
+
+	It("validates a Go method using sdk.Coin against a (string,uint256) tuple", func() {
+		impl := &mockCoinImpl{}
+		sendCoin, found := reflect.TypeOf(impl).MethodByName("SendCoin")
+		Expect(found).To(BeTrue())
+
+		methodName, err := findMatchingABIMethod(sendCoin, sendCoinABI, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(methodName).To(Equal("sendCoin"))
+	})
+
+	It("fails the raw type comparison once the adapter is removed", func() {
+		prev := defaultAdapters
+		defaultAdapters = NewAdapterRegistry()
+		defer func() { defaultAdapters = prev }()
+
+		impl := &mockCoinImpl{}
+		sendCoin, found := reflect.TypeOf(impl).MethodByName("SendCoin")
+		Expect(found).To(BeTrue())
+
+		// "SendCoin" is an exact, case-insensitive name match for "sendCoin", and
+		// findMatchingABIMethod treats a signature mismatch on an exact match as an error
+		// rather than silently reporting no match.
+		methodName, err := findMatchingABIMethod(sendCoin, sendCoinABI, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(methodName).To(Equal(""))
+	})
+
+	It("lets a container-local adapter rescue a method the default registry alone would reject", func() {
+		prev := defaultAdapters
+		defaultAdapters = NewAdapterRegistry()
+		defer func() { defaultAdapters = prev }()
+
+		impl := &mockCoinImpl{}
+
+		_, err := NewContainer(impl, sendCoinABI)
+		Expect(err).To(HaveOccurred())
+
+		container, err := NewContainer(impl, sendCoinABI,
+			WithTypeAdapter(reflect.TypeOf(sdk.Coin{}), sdkCoinAdapter{}))
+		Expect(err).ToNot(HaveOccurred())
+
+		sendCoin := sendCoinABI["sendCoin"]
+		input, err := sendCoin.Inputs.Pack(cosmosCoin{Denom: "abera", Amount: big.NewInt(100)})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = container.Call(context.Background(), selectorOf(sendCoin.ID), input)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("round-trips a coin through Container.Call", func() {
+		impl := &mockCoinImpl{}
+		container, err := NewContainer(impl, sendCoinABI)
+		Expect(err).ToNot(HaveOccurred())
+
+		sendCoin := sendCoinABI["sendCoin"]
+		input, err := sendCoin.Inputs.Pack(cosmosCoin{Denom: "abera", Amount: big.NewInt(100)})
+		Expect(err).ToNot(HaveOccurred())
+
+		out, err := container.Call(context.Background(), selectorOf(sendCoin.ID), input)
+		Expect(err).ToNot(HaveOccurred())
+
+		// sdkCoinAdapter.FromEVM must have decoded the tuple abi.Arguments.Unpack hands back
+		// (its own anonymous struct type, not cosmosCoin) into an sdk.Coin for SendCoin to see.
+		Expect(impl.received.Denom).To(Equal("abera"))
+		Expect(impl.received.Amount.BigInt()).To(Equal(big.NewInt(100)))
+
+		unpacked, err := sendCoin.Outputs.Unpack(out)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unpacked[0]).To(Equal(true))
+	})
+})
+
+// mockCoinImpl exercises the built-in sdk.Coin TypeAdapter.
+type mockCoinImpl struct {
+	received sdk.Coin
+}
+
+func (m *mockCoinImpl) SendCoin(_ context.Context, coin sdk.Coin) (bool, error) {
+	m.received = coin
+
+	return true, nil
+}